@@ -0,0 +1,232 @@
+package main
+
+import (
+	"container/list"
+	"hash/fnv"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Cache — абстракция над хранилищем заказов в оперативной памяти. Позволяет
+// подменить реализацию (LRU, metrics-обертку) без изменения read model и проекций.
+type Cache interface {
+	Get(orderUID string) (*Order, bool)
+	Set(orderUID string, order *Order)
+	Delete(orderUID string)
+	Len() int
+}
+
+const cacheShardCount = 16
+
+// lruCache — кэш с фиксированной емкостью и TTL, шардированный по хэшу OrderUID,
+// чтобы снизить конкуренцию за один общий мьютекс под нагрузкой.
+type lruCache struct {
+	shards   [cacheShardCount]*lruShard
+	capacity int // суммарная емкость кэша, поровну поделенная между шардами
+	ttl      time.Duration
+}
+
+type lruShard struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	items    map[string]*list.Element
+	order    *list.List // список элементов от самого свежего к самому старому
+}
+
+type lruEntry struct {
+	key       string
+	order     *Order
+	expiresAt time.Time
+}
+
+// NewLRUCache создает кэш заказов с общей емкостью capacity и временем жизни ttl,
+// поделенный на шарды по хэшу OrderUID.
+func NewLRUCache(capacity int, ttl time.Duration) *lruCache {
+	c := &lruCache{capacity: capacity, ttl: ttl}
+	perShard := capacity / cacheShardCount
+	if perShard < 1 {
+		perShard = 1
+	}
+	for i := range c.shards {
+		c.shards[i] = &lruShard{
+			capacity: perShard,
+			ttl:      ttl,
+			items:    make(map[string]*list.Element),
+			order:    list.New(),
+		}
+	}
+	return c
+}
+
+func (c *lruCache) shardFor(orderUID string) *lruShard {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(orderUID))
+	return c.shards[h.Sum32()%cacheShardCount]
+}
+
+func (c *lruCache) Get(orderUID string) (*Order, bool) {
+	return c.shardFor(orderUID).get(orderUID)
+}
+
+func (c *lruCache) Set(orderUID string, order *Order) {
+	c.shardFor(orderUID).set(orderUID, order)
+}
+
+func (c *lruCache) Delete(orderUID string) {
+	c.shardFor(orderUID).delete(orderUID)
+}
+
+func (c *lruCache) Len() int {
+	total := 0
+	for _, shard := range c.shards {
+		total += shard.len()
+	}
+	return total
+}
+
+func (s *lruShard) get(key string) (*Order, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*lruEntry)
+	if s.ttl > 0 && time.Now().After(entry.expiresAt) {
+		s.order.Remove(elem)
+		delete(s.items, key)
+		return nil, false
+	}
+	s.order.MoveToFront(elem)
+	return entry.order, true
+}
+
+func (s *lruShard) set(key string, order *Order) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt := time.Time{}
+	if s.ttl > 0 {
+		expiresAt = time.Now().Add(s.ttl)
+	}
+
+	if elem, ok := s.items[key]; ok {
+		elem.Value = &lruEntry{key: key, order: order, expiresAt: expiresAt}
+		s.order.MoveToFront(elem)
+		return
+	}
+
+	elem := s.order.PushFront(&lruEntry{key: key, order: order, expiresAt: expiresAt})
+	s.items[key] = elem
+
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		if oldest == nil {
+			break
+		}
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(*lruEntry).key)
+		cacheEvictionsTotal.Inc()
+	}
+}
+
+func (s *lruShard) delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elem, ok := s.items[key]; ok {
+		s.order.Remove(elem)
+		delete(s.items, key)
+	}
+}
+
+func (s *lruShard) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+// Prometheus-метрики кэша
+var (
+	cacheHitsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cache_hits_total",
+		Help: "Total number of order cache hits.",
+	})
+	cacheMissesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cache_misses_total",
+		Help: "Total number of order cache misses.",
+	})
+	cacheEvictionsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "cache_evictions_total",
+		Help: "Total number of order cache evictions.",
+	})
+	cacheSizeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "cache_size",
+		Help: "Current number of orders held in the cache.",
+	})
+)
+
+// metricsCache оборачивает Cache, инструментируя его Prometheus-метриками;
+// именно это значение должно использоваться остальным приложением.
+type metricsCache struct {
+	inner Cache
+}
+
+// NewMetricsCache оборачивает inner, публикуя cache_hits_total, cache_misses_total,
+// cache_evictions_total и cache_size.
+func NewMetricsCache(inner Cache) *metricsCache {
+	return &metricsCache{inner: inner}
+}
+
+func (c *metricsCache) Get(orderUID string) (*Order, bool) {
+	order, ok := c.inner.Get(orderUID)
+	if ok {
+		cacheHitsTotal.Inc()
+	} else {
+		cacheMissesTotal.Inc()
+	}
+	return order, ok
+}
+
+func (c *metricsCache) Set(orderUID string, order *Order) {
+	c.inner.Set(orderUID, order)
+	cacheSizeGauge.Set(float64(c.inner.Len()))
+}
+
+func (c *metricsCache) Delete(orderUID string) {
+	c.inner.Delete(orderUID)
+	cacheSizeGauge.Set(float64(c.inner.Len()))
+}
+
+func (c *metricsCache) Len() int {
+	return c.inner.Len()
+}
+
+// getEnvInt читает целочисленную конфигурацию из переменной окружения,
+// возвращая fallback, если переменная не задана или некорректна.
+func getEnvInt(key string, fallback int) int {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return fallback
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+// getEnvString читает строковую конфигурацию из переменной окружения,
+// возвращая fallback, если переменная не задана.
+func getEnvString(key, fallback string) string {
+	if raw := os.Getenv(key); raw != "" {
+		return raw
+	}
+	return fallback
+}
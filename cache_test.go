@@ -0,0 +1,60 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// TestLRUShardEviction проверяет, что при превышении capacity вытесняется
+// самый давно использованный элемент, а не произвольный.
+func TestLRUShardEviction(t *testing.T) {
+	shard := newTestShard(2, 0)
+
+	shard.set("a", &Order{OrderUID: "a"})
+	shard.set("b", &Order{OrderUID: "b"})
+
+	// touch "a" so "b" becomes the least recently used
+	if _, ok := shard.get("a"); !ok {
+		t.Fatalf("expected %q to be present before eviction", "a")
+	}
+
+	shard.set("c", &Order{OrderUID: "c"})
+
+	if _, ok := shard.get("b"); ok {
+		t.Fatalf("expected %q to be evicted as least recently used", "b")
+	}
+	if _, ok := shard.get("a"); !ok {
+		t.Fatalf("expected %q to survive eviction (recently touched)", "a")
+	}
+	if _, ok := shard.get("c"); !ok {
+		t.Fatalf("expected %q to be present after insert", "c")
+	}
+	if got := shard.len(); got != 2 {
+		t.Fatalf("shard.len() = %d, want 2", got)
+	}
+}
+
+// TestLRUShardTTLExpiry проверяет, что запись становится недоступной после
+// истечения ttl, даже если capacity не превышена.
+func TestLRUShardTTLExpiry(t *testing.T) {
+	shard := newTestShard(10, time.Millisecond)
+
+	shard.set("a", &Order{OrderUID: "a"})
+
+	if _, ok := shard.get("a"); !ok {
+		t.Fatalf("expected %q to be present immediately after set", "a")
+	}
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := shard.get("a"); ok {
+		t.Fatalf("expected %q to have expired after ttl", "a")
+	}
+	if got := shard.len(); got != 0 {
+		t.Fatalf("shard.len() = %d, want 0 after expired entry is reaped by get", got)
+	}
+}
+
+func newTestShard(capacity int, ttl time.Duration) *lruShard {
+	return NewLRUCache(capacity*cacheShardCount, ttl).shards[0]
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/KarimMull/L0/pkg/orderpb"
+)
+
+// orderGRPCServer implements orderpb.OrderServiceServer on top of QueryService,
+// so gRPC and HTTP clients are always backed by the same query path.
+type orderGRPCServer struct {
+	orderpb.UnimplementedOrderServiceServer
+	query *QueryService
+}
+
+func (s *orderGRPCServer) GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.Order, error) {
+	order, exists := s.query.GetOrder(req.OrderUid)
+	if !exists {
+		return nil, fmt.Errorf("order %s not found", req.OrderUid)
+	}
+	return toProtoOrder(order), nil
+}
+
+func (s *orderGRPCServer) ListOrdersByCustomer(ctx context.Context, req *orderpb.ListOrdersByCustomerRequest) (*orderpb.ListOrdersResponse, error) {
+	orders, err := s.query.ListOrdersByCustomer(req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	return &orderpb.ListOrdersResponse{Orders: toProtoOrders(orders)}, nil
+}
+
+func (s *orderGRPCServer) ListOrdersByTrack(ctx context.Context, req *orderpb.ListOrdersByTrackRequest) (*orderpb.ListOrdersResponse, error) {
+	order, err := s.query.ListOrdersByTrack(req.TrackNumber)
+	if err != nil {
+		return nil, err
+	}
+	if order == nil {
+		return &orderpb.ListOrdersResponse{}, nil
+	}
+	return &orderpb.ListOrdersResponse{Orders: []*orderpb.Order{toProtoOrder(order)}}, nil
+}
+
+func (s *orderGRPCServer) StreamOrderUpdates(req *orderpb.StreamOrderUpdatesRequest, stream orderpb.OrderService_StreamOrderUpdatesServer) error {
+	updates, unsubscribe := s.query.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case order, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toProtoOrder(order)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+func toProtoOrder(order *Order) *orderpb.Order {
+	items := make([]*orderpb.Item, 0, len(order.Items))
+	for _, item := range order.Items {
+		items = append(items, &orderpb.Item{
+			ChrtId:      int32(item.ChrtID),
+			TrackNumber: item.TrackNumber,
+			Price:       int32(item.Price),
+			Rid:         item.RID,
+			Name:        item.Name,
+			Sale:        int32(item.Sale),
+			Size:        item.Size,
+			TotalPrice:  int32(item.TotalPrice),
+			NmId:        int32(item.NmID),
+			Brand:       item.Brand,
+			Status:      int32(item.Status),
+		})
+	}
+
+	return &orderpb.Order{
+		OrderUid:    order.OrderUID,
+		TrackNumber: order.TrackNumber,
+		Entry:       order.Entry,
+		Delivery: &orderpb.Delivery{
+			Name:    order.Delivery.Name,
+			Phone:   order.Delivery.Phone,
+			Zip:     order.Delivery.Zip,
+			City:    order.Delivery.City,
+			Address: order.Delivery.Address,
+			Region:  order.Delivery.Region,
+			Email:   order.Delivery.Email,
+		},
+		Payment: &orderpb.Payment{
+			Transaction:  order.Payment.Transaction,
+			RequestId:    order.Payment.RequestID,
+			Currency:     order.Payment.Currency,
+			Provider:     order.Payment.Provider,
+			Amount:       int32(order.Payment.Amount),
+			PaymentDt:    int32(order.Payment.PaymentDT),
+			Bank:         order.Payment.Bank,
+			DeliveryCost: int32(order.Payment.DeliveryCost),
+			GoodsTotal:   int32(order.Payment.GoodsTotal),
+			CustomFee:    int32(order.Payment.CustomFee),
+		},
+		Items:             items,
+		Locale:            order.Locale,
+		InternalSignature: order.InternalSignature,
+		CustomerId:        order.CustomerID,
+		DeliveryService:   order.DeliveryService,
+		Shardkey:          order.Shardkey,
+		SmId:              int32(order.SmID),
+		DateCreated:       order.DateCreated,
+		OofShard:          order.OOFShard,
+		Status:            order.Status,
+	}
+}
+
+func toProtoOrders(orders []*Order) []*orderpb.Order {
+	result := make([]*orderpb.Order, 0, len(orders))
+	for _, order := range orders {
+		result = append(result, toProtoOrder(order))
+	}
+	return result
+}
+
+// startGRPCServer starts the gRPC query API on its own port, alongside the
+// existing HTTP frontend started in main.
+func startGRPCServer(addr string, query *QueryService) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s for gRPC: %v", addr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	orderpb.RegisterOrderServiceServer(grpcServer, &orderGRPCServer{query: query})
+
+	log.Printf("gRPC server running on %s...", addr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("gRPC server failed: %v", err)
+	}
+}
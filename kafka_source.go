@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// kafkaSource is the OrderSource this service has always used: at-least-once
+// delivery via FetchMessage/CommitMessages, with retries and a DLQ for
+// messages that never make it through.
+type kafkaSource struct{}
+
+// Subscribe reads with FetchMessage (no auto-commit) and only calls
+// CommitMessages after the message is durably persisted and handler has run,
+// retrying failures with exponential backoff up to maxProcessRetries before
+// routing to the DLQ.
+func (s *kafkaSource) Subscribe(ctx context.Context, handler func(Order) error) error {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: []string{kafkaBroker},
+		Topic:   kafkaTopic,
+		GroupID: "order_service",
+	})
+	defer r.Close()
+
+	for {
+		m, err := r.FetchMessage(ctx)
+		if err != nil {
+			log.Printf("Error fetching message: %v", err)
+			continue
+		}
+
+		if err := s.processWithRetry(ctx, m, handler); err != nil {
+			log.Printf("Giving up on order message after retries, sending to DLQ: %v", err)
+			if dlqErr := publishToDLQTopic(ctx, kafkaDLQTopic, m.Key, m.Value); dlqErr != nil {
+				log.Printf("Error publishing to DLQ: %v", dlqErr)
+				continue // не коммитим: попробуем заново на следующем проходе
+			}
+			ordersDLQTotal.Inc()
+		} else {
+			ordersProcessedTotal.Inc()
+		}
+
+		if err := r.CommitMessages(ctx, m); err != nil {
+			log.Printf("Error committing message offset: %v", err)
+		}
+	}
+}
+
+// processWithRetry decodes and durably persists m, then invokes handler,
+// retrying the whole sequence with backoff before giving up.
+func (s *kafkaSource) processWithRetry(ctx context.Context, m kafka.Message, handler func(Order) error) error {
+	order, err := decodeOrderMessage(m.Value)
+	if err != nil {
+		return err
+	}
+
+	messageID := fmt.Sprintf("%d:%d", m.Partition, m.Offset)
+	if err := persistWithRetry(ctx, order, messageID); err != nil {
+		return err
+	}
+
+	return handler(order)
+}
+
+// PublishOrderKafka publishes order to the main Kafka orders topic, so tests
+// and downstream services can inject orders the same way production does.
+func PublishOrderKafka(ctx context.Context, order Order) error {
+	payload, err := encodeOrder(order)
+	if err != nil {
+		return err
+	}
+
+	w := &kafka.Writer{
+		Addr:  kafka.TCP(kafkaBroker),
+		Topic: kafkaTopic,
+	}
+	defer w.Close()
+
+	return w.WriteMessages(ctx, kafka.Message{Key: []byte(order.OrderUID), Value: payload})
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Статусы жизненного цикла заказа
+const (
+	StatusCreated   = "created"
+	StatusPaid      = "paid"
+	StatusShipped   = "shipped"
+	StatusCancelled = "cancelled"
+	StatusRefunded  = "refunded"
+)
+
+// LifecycleEvent — событие, переводящее заказ из одного статуса в другой
+type LifecycleEvent string
+
+const (
+	EventPay    LifecycleEvent = "pay"
+	EventShip   LifecycleEvent = "ship"
+	EventCancel LifecycleEvent = "cancel"
+	EventRefund LifecycleEvent = "refund"
+)
+
+// allowedTransitions описывает допустимые переходы статуса для каждого события;
+// любой переход, отсутствующий здесь, считается нелегальным (например, cancel после shipped).
+var allowedTransitions = map[LifecycleEvent]map[string]string{
+	EventPay:    {StatusCreated: StatusPaid},
+	EventShip:   {StatusPaid: StatusShipped},
+	EventCancel: {StatusCreated: StatusCancelled, StatusPaid: StatusCancelled},
+	EventRefund: {StatusPaid: StatusRefunded, StatusShipped: StatusRefunded},
+}
+
+// lifecycleEventTarget — статус, в который событие переводит заказ при успехе.
+// Используется, чтобы отличить нелегальный переход от редоставки события,
+// которое уже было применено: если текущий статус уже равен целевому, событие
+// считается no-op, а не ошибкой.
+var lifecycleEventTarget = map[LifecycleEvent]string{
+	EventPay:    StatusPaid,
+	EventShip:   StatusShipped,
+	EventCancel: StatusCancelled,
+	EventRefund: StatusRefunded,
+}
+
+// Transition проверяет и выполняет переход статуса заказа по событию,
+// отклоняя нелегальные переходы (например, cancel после shipped).
+func (o *Order) Transition(event LifecycleEvent) error {
+	nextByCurrent, ok := allowedTransitions[event]
+	if !ok {
+		return fmt.Errorf("unknown lifecycle event %q", event)
+	}
+	next, ok := nextByCurrent[o.Status]
+	if !ok {
+		return fmt.Errorf("illegal transition: event %q from status %q", event, o.Status)
+	}
+	o.Status = next
+	return nil
+}
+
+// Создание таблицы order_status_history, если она еще не существует
+func createStatusHistoryTable(db *sql.DB) error {
+	query := `
+	CREATE TABLE IF NOT EXISTS order_status_history (
+		order_uid TEXT NOT NULL,
+		old_status TEXT NOT NULL,
+		new_status TEXT NOT NULL,
+		changed_at TIMESTAMP NOT NULL
+	);`
+	_, err := db.Exec(query)
+	return err
+}
+
+// applyLifecycleEvent загружает текущий заказ, проверяет переход через Transition,
+// затем, как и persistOrder, фиксирует изменение как status_changed в
+// order_events и применяет его через projections (в той же транзакции), вместо
+// того чтобы писать status/payment в orders напрямую — иначе order_events не
+// содержал бы переходов статуса, и ProjectionRebuilder.Rebuild не смог бы
+// восстановить status при пересборке read model. Дополнительно пишет запись в
+// order_status_history (человекочитаемый аудит, не проекция), а затем обновляет кэш.
+//
+// readModel.GetByUID возвращает тот же *Order, что лежит в кэше: мутировать его
+// поля до commit означало бы отдавать concurrent-читателям (orderHandler, gRPC)
+// ещё не зафиксированный в БД переход. Поэтому Transition применяется к копии,
+// а orderCache.Set — только после успешного commit.
+//
+// messageID, если непустой, — ключ идемпотентности из inbox_processed (как и в
+// persistOrder): под at-least-once доставкой consumeLifecycleTopic может
+// повторно доставить уже обработанное сообщение, и без этой проверки Transition
+// отклонил бы повтор как нелегальный переход (например, EventPay из "paid"),
+// уводя успешно обработанное событие в DLQ. HTTP-обработчики (cancelHandler,
+// paymentCallbackHandler) не участвуют в редоставке и передают "". В обоих
+// случаях повтор события, уже приведшего заказ в целевой статус
+// (lifecycleEventTarget), тоже считается no-op, а не ошибкой.
+func applyLifecycleEvent(ctx context.Context, db *sql.DB, orderUID string, event LifecycleEvent, payment *Payment, messageID string) error {
+	cached, exists := readModel.GetByUID(orderUID)
+	if !exists {
+		return fmt.Errorf("order %s not found", orderUID)
+	}
+	order := *cached
+
+	oldStatus := order.Status
+	if oldStatus == "" {
+		oldStatus = StatusCreated
+	}
+	order.Status = oldStatus
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	if messageID != "" {
+		var alreadyProcessed bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM inbox_processed WHERE message_id = $1)`, messageID).Scan(&alreadyProcessed); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("check inbox: %w", err)
+		}
+		if alreadyProcessed {
+			tx.Rollback()
+			return nil
+		}
+	}
+
+	if err := order.Transition(event); err != nil {
+		if target, ok := lifecycleEventTarget[event]; ok && oldStatus == target {
+			tx.Rollback()
+			return nil
+		}
+		tx.Rollback()
+		return err
+	}
+
+	if payment != nil {
+		order.Payment = *payment
+	}
+
+	payload, err := json.Marshal(order)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("marshal order snapshot: %w", err)
+	}
+	statusEvent := OrderEvent{
+		EventID:    fmt.Sprintf("%s:%s:%d", orderUID, eventTypeStatusChanged, time.Now().UnixNano()),
+		OrderUID:   orderUID,
+		Type:       eventTypeStatusChanged,
+		Payload:    payload,
+		OccurredAt: time.Now(),
+		Version:    1,
+	}
+	if err := appendOrderEvent(tx, statusEvent); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("append status event: %w", err)
+	}
+	for _, projection := range projections {
+		if err := projection.Apply(ctx, tx, statusEvent, &order); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("apply projection for status event: %w", err)
+		}
+	}
+
+	if _, err := tx.Exec(
+		`INSERT INTO order_status_history (order_uid, old_status, new_status, changed_at) VALUES ($1, $2, $3, $4)`,
+		orderUID, oldStatus, order.Status, time.Now(),
+	); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("insert status history: %w", err)
+	}
+
+	if messageID != "" {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO inbox_processed (message_id, processed_at) VALUES ($1, $2)`,
+			messageID, time.Now(),
+		); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("mark inbox processed: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit lifecycle event: %w", err)
+	}
+
+	orderCache.Set(orderUID, &order)
+
+	return nil
+}
+
+// cancelRequest — тело запроса POST /order/cancel
+type cancelRequest struct {
+	OrderUID string `json:"order_uid"`
+}
+
+// cancelHandler отменяет заказ, если это допустимо текущим статусом
+func cancelHandler(w http.ResponseWriter, r *http.Request) {
+	var req cancelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.OrderUID == "" {
+		http.Error(w, "order_uid is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := applyLifecycleEvent(r.Context(), db, req.OrderUID, EventCancel, nil, ""); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// paymentCallbackRequest — тело запроса POST /order/payment-callback
+type paymentCallbackRequest struct {
+	OrderUID string  `json:"order_uid"`
+	Payment  Payment `json:"payment"`
+}
+
+// paymentCallbackHandler фиксирует оплату заказа, переводя его created -> paid
+func paymentCallbackHandler(w http.ResponseWriter, r *http.Request) {
+	var req paymentCallbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if req.OrderUID == "" {
+		http.Error(w, "order_uid is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := applyLifecycleEvent(r.Context(), db, req.OrderUID, EventPay, &req.Payment, ""); err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// lifecycleKafkaMessage — формат сообщений в topics orders.cancel и orders.payment
+type lifecycleKafkaMessage struct {
+	OrderUID string   `json:"order_uid"`
+	Payment  *Payment `json:"payment,omitempty"`
+}
+
+// consumeLifecycleTopic читает сообщения из topic и применяет к заказу указанное
+// событие жизненного цикла; используется для orders.cancel и orders.payment
+// наравне с основным kafkaSource — тот же at-least-once путь: FetchMessage без
+// авто-коммита, ретраи с backoff, DLQ для сообщений, не прошедших их все, и
+// CommitMessages только после того, как сообщение обработано или ушло в DLQ.
+func consumeLifecycleTopic(topic string, event LifecycleEvent) {
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: []string{kafkaBroker},
+		Topic:   topic,
+		GroupID: "order_service",
+	})
+	defer r.Close()
+
+	dlqTopic := topic + ".dlq"
+
+	for {
+		m, err := r.FetchMessage(context.Background())
+		if err != nil {
+			log.Printf("Error fetching message from %s: %v", topic, err)
+			continue
+		}
+
+		messageID := fmt.Sprintf("%s:%d:%d", topic, m.Partition, m.Offset)
+		if err := applyLifecycleEventWithRetry(context.Background(), m.Value, messageID, topic, event); err != nil {
+			log.Printf("Giving up on %s message after retries, sending to DLQ: %v", topic, err)
+			if dlqErr := publishToDLQTopic(context.Background(), dlqTopic, m.Key, m.Value); dlqErr != nil {
+				log.Printf("Error publishing to DLQ: %v", dlqErr)
+				continue // не коммитим: попробуем заново на следующем проходе
+			}
+			ordersDLQTotal.Inc()
+		} else {
+			ordersProcessedTotal.Inc()
+		}
+
+		if err := r.CommitMessages(context.Background(), m); err != nil {
+			log.Printf("Error committing %s message offset: %v", topic, err)
+		}
+	}
+}
+
+// applyLifecycleEventWithRetry decodes raw, then retries applyLifecycleEvent
+// with the same exponential backoff persistWithRetry uses for order ingestion,
+// giving up after maxProcessRetries. messageID (topic-qualified partition:offset)
+// makes redelivery of the same message a no-op via the inbox_processed check in
+// applyLifecycleEvent, instead of failing Transition and burning all retries.
+func applyLifecycleEventWithRetry(ctx context.Context, raw []byte, messageID, topic string, event LifecycleEvent) error {
+	var msg lifecycleKafkaMessage
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return fmt.Errorf("unmarshal %s message: %w", topic, err)
+	}
+
+	backoff := initialBackoff
+	var lastErr error
+	for attempt := 1; attempt <= maxProcessRetries; attempt++ {
+		if err := applyLifecycleEvent(ctx, db, msg.OrderUID, event, msg.Payment, messageID); err != nil {
+			lastErr = err
+			ordersFailedTotal.Inc()
+			log.Printf("Attempt %d/%d failed applying %s event for order %s: %v", attempt, maxProcessRetries, topic, msg.OrderUID, err)
+			time.Sleep(backoff)
+			backoff *= 2
+			continue
+		}
+		log.Printf("Order %s transitioned via %s", msg.OrderUID, topic)
+		return nil
+	}
+	return lastErr
+}
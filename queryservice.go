@@ -0,0 +1,73 @@
+package main
+
+import "sync"
+
+// QueryService is the single place that answers order queries, used by both
+// orderHandler and the gRPC server so the two frontends never diverge.
+type QueryService struct {
+	readModel   ReadModel
+	broadcaster *orderBroadcaster
+}
+
+// NewQueryService wires a QueryService on top of the shared read model.
+func NewQueryService(readModel ReadModel, broadcaster *orderBroadcaster) *QueryService {
+	return &QueryService{readModel: readModel, broadcaster: broadcaster}
+}
+
+func (q *QueryService) GetOrder(orderUID string) (*Order, bool) {
+	return q.readModel.GetByUID(orderUID)
+}
+
+func (q *QueryService) ListOrdersByCustomer(customerID string) ([]*Order, error) {
+	return q.readModel.GetByCustomerID(customerID)
+}
+
+func (q *QueryService) ListOrdersByTrack(trackNumber string) (*Order, error) {
+	return q.readModel.GetByTrackNumber(trackNumber)
+}
+
+// Subscribe registers a channel that receives every order processed by
+// kafkaConsumer from now on; call the returned func to unsubscribe.
+func (q *QueryService) Subscribe() (<-chan *Order, func()) {
+	return q.broadcaster.subscribe()
+}
+
+// orderBroadcaster fans new orders out to every StreamOrderUpdates subscriber.
+type orderBroadcaster struct {
+	mu          sync.Mutex
+	subscribers map[chan *Order]struct{}
+}
+
+func newOrderBroadcaster() *orderBroadcaster {
+	return &orderBroadcaster{subscribers: make(map[chan *Order]struct{})}
+}
+
+func (b *orderBroadcaster) subscribe() (<-chan *Order, func()) {
+	ch := make(chan *Order, 16)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+		close(ch)
+	}
+	return ch, unsubscribe
+}
+
+// publish fans order out to every current subscriber; a slow subscriber whose
+// buffer is full is skipped rather than blocking kafkaConsumer.
+func (b *orderBroadcaster) publish(order *Order) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- order:
+		default:
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ReadModel отвечает за все запросы, которые сегодня обслуживает orderHandler,
+// и за будущие (история по клиенту, поиск по треку), не затрагивая путь записи.
+type ReadModel interface {
+	GetByUID(orderUID string) (*Order, bool)
+	GetByCustomerID(customerID string) ([]*Order, error)
+	GetByTrackNumber(trackNumber string) (*Order, error)
+	GetByDateRange(from, to time.Time) ([]*Order, error)
+}
+
+// cacheReadModel — read model поверх ограниченного по размеру кэша orderCache.
+// Используется для самого частого запроса (по order_uid), где важна задержка;
+// при промахе заказ подгружается из fallback (Postgres) и кладется в кэш (lazy load).
+type cacheReadModel struct {
+	fallback *postgresReadModel
+}
+
+func (m *cacheReadModel) GetByUID(orderUID string) (*Order, bool) {
+	if order, ok := orderCache.Get(orderUID); ok {
+		return order, true
+	}
+
+	order, exists := m.fallback.GetByUID(orderUID)
+	if !exists {
+		return nil, false
+	}
+	orderCache.Set(orderUID, order)
+	return order, true
+}
+
+func (m *cacheReadModel) GetByCustomerID(customerID string) ([]*Order, error) {
+	return m.fallback.GetByCustomerID(customerID)
+}
+
+func (m *cacheReadModel) GetByTrackNumber(trackNumber string) (*Order, error) {
+	return m.fallback.GetByTrackNumber(trackNumber)
+}
+
+func (m *cacheReadModel) GetByDateRange(from, to time.Time) ([]*Order, error) {
+	return m.fallback.GetByDateRange(from, to)
+}
+
+// postgresReadModel — read model поверх денормализованной вьюхи orders_view,
+// оптимизированной под запросы по customer_id, track_number и диапазону дат.
+type postgresReadModel struct {
+	db *sql.DB
+}
+
+// createOrdersView создает денормализованную вьюху для запросов orderHandler,
+// избавляя read-путь от необходимости собирать JSON на лету.
+func createOrdersView(db *sql.DB) error {
+	query := `
+	CREATE OR REPLACE VIEW orders_view AS
+	SELECT order_uid, track_number, customer_id, date_created, delivery, payment, items, status
+	FROM orders;`
+	_, err := db.Exec(query)
+	return err
+}
+
+func (m *postgresReadModel) GetByUID(orderUID string) (*Order, bool) {
+	order, err := m.scanOne(`SELECT order_uid, track_number, customer_id, date_created, delivery, payment, items, status FROM orders_view WHERE order_uid = $1`, orderUID)
+	if err != nil {
+		return nil, false
+	}
+	return order, order != nil
+}
+
+func (m *postgresReadModel) GetByCustomerID(customerID string) ([]*Order, error) {
+	return m.scanMany(`SELECT order_uid, track_number, customer_id, date_created, delivery, payment, items, status FROM orders_view WHERE customer_id = $1`, customerID)
+}
+
+func (m *postgresReadModel) GetByTrackNumber(trackNumber string) (*Order, error) {
+	return m.scanOne(`SELECT order_uid, track_number, customer_id, date_created, delivery, payment, items, status FROM orders_view WHERE track_number = $1`, trackNumber)
+}
+
+func (m *postgresReadModel) GetByDateRange(from, to time.Time) ([]*Order, error) {
+	return m.scanMany(`SELECT order_uid, track_number, customer_id, date_created, delivery, payment, items, status FROM orders_view WHERE date_created BETWEEN $1 AND $2`, from, to)
+}
+
+func (m *postgresReadModel) scanOne(query string, args ...interface{}) (*Order, error) {
+	orders, err := m.scanMany(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	return orders[0], nil
+}
+
+func (m *postgresReadModel) scanMany(query string, args ...interface{}) ([]*Order, error) {
+	rows, err := m.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query orders_view: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []*Order
+	for rows.Next() {
+		var (
+			order        Order
+			deliveryJSON []byte
+			paymentJSON  []byte
+			itemsJSON    []byte
+		)
+		if err := rows.Scan(&order.OrderUID, &order.TrackNumber, &order.CustomerID, &order.DateCreated, &deliveryJSON, &paymentJSON, &itemsJSON, &order.Status); err != nil {
+			return nil, fmt.Errorf("scan orders_view row: %w", err)
+		}
+		_ = json.Unmarshal(deliveryJSON, &order.Delivery)
+		_ = json.Unmarshal(paymentJSON, &order.Payment)
+		_ = json.Unmarshal(itemsJSON, &order.Items)
+		orders = append(orders, &order)
+	}
+	return orders, rows.Err()
+}
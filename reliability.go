@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/segmentio/kafka-go"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	kafkaDLQTopic = "orders.dlq" // Топик для сообщений, которые не удалось обработать
+
+	maxProcessRetries = 5                      // Максимум попыток перед отправкой в DLQ
+	initialBackoff    = 200 * time.Millisecond // Начальная задержка экспоненциального backoff
+)
+
+// orderJSONSchema описывает минимально необходимую форму Order перед записью в БД;
+// сообщения, не прошедшие валидацию, сразу уходят в DLQ, не тратя попытки ретрая.
+// Используется обоими транспортами (Kafka, NATS).
+const orderJSONSchema = `{
+	"type": "object",
+	"required": ["order_uid", "track_number"],
+	"properties": {
+		"order_uid": {"type": "string", "minLength": 1},
+		"track_number": {"type": "string", "minLength": 1}
+	}
+}`
+
+var orderSchemaLoader = gojsonschema.NewStringLoader(orderJSONSchema)
+
+// Метрики надежности пайплайна сообщений, общие для всех реализаций OrderSource
+var (
+	ordersProcessedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orders_processed_total",
+		Help: "Total number of order messages successfully committed.",
+	})
+	ordersFailedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orders_failed_total",
+		Help: "Total number of failed order processing attempts.",
+	})
+	ordersDLQTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orders_dlq_total",
+		Help: "Total number of order messages published to the DLQ.",
+	})
+)
+
+// Создание таблицы inbox_processed, если она еще не существует.
+// message_id — детерминированный ключ обработки (для Kafka: partition:offset,
+// для NATS: stream-sequence), делающий повторную доставку одного и того же
+// сообщения идемпотентной.
+func createInboxTable(db *sql.DB) error {
+	query := `
+	CREATE TABLE IF NOT EXISTS inbox_processed (
+		message_id TEXT PRIMARY KEY,
+		processed_at TIMESTAMP NOT NULL
+	);`
+	_, err := db.Exec(query)
+	return err
+}
+
+// validateOrderSchema проверяет сырое сообщение на соответствие orderJSONSchema
+// до того, как оно становится кандидатом на запись в БД.
+func validateOrderSchema(raw []byte) error {
+	result, err := gojsonschema.Validate(orderSchemaLoader, gojsonschema.NewBytesLoader(raw))
+	if err != nil {
+		return fmt.Errorf("validate schema: %w", err)
+	}
+	if !result.Valid() {
+		return fmt.Errorf("order does not match schema: %v", result.Errors())
+	}
+	return nil
+}
+
+// decodeOrderMessage validates raw bytes against orderJSONSchema, unmarshals
+// them into an Order, and applies the same defaulting used everywhere else.
+func decodeOrderMessage(raw []byte) (Order, error) {
+	var order Order
+	if err := validateOrderSchema(raw); err != nil {
+		return order, fmt.Errorf("schema validation failed: %w", err)
+	}
+	if err := json.Unmarshal(raw, &order); err != nil {
+		return order, fmt.Errorf("unmarshal order: %w", err)
+	}
+	if err := validateOrder(&order); err != nil {
+		return order, fmt.Errorf("invalid order: %w", err)
+	}
+	if order.Status == "" {
+		order.Status = StatusCreated
+	}
+	return order, nil
+}
+
+// encodeOrder marshals order the same way it is read back by decodeOrderMessage,
+// so publisher helpers for either transport produce messages consumers accept.
+func encodeOrder(order Order) ([]byte, error) {
+	return json.Marshal(order)
+}
+
+// persistOrder писает один заказ внутри одной транзакции, покрывающей запись
+// события в order_events, все сконфигурированные проекции (см. main.go) и
+// отметку messageID в inbox_processed, так что подтверждение доставки
+// транспорту (commit оффсета Kafka, Ack сообщения NATS) происходит только
+// после успеха, а повторная доставка того же messageID — no-op. Проекции
+// проходят тот же путь, что и при ProjectionRebuilder.Rebuild после рестарта,
+// так что новая проекция в списке актуальна для live-заказов сразу, без
+// ожидания следующего рестарта.
+func persistOrder(ctx context.Context, db *sql.DB, order Order, messageID string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	var alreadyProcessed bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM inbox_processed WHERE message_id = $1)`, messageID).Scan(&alreadyProcessed); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("check inbox: %w", err)
+	}
+
+	if !alreadyProcessed {
+		payload, err := json.Marshal(order)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("marshal order payload: %w", err)
+		}
+		event := OrderEvent{
+			EventID:    fmt.Sprintf("%s:%s", order.OrderUID, eventTypeOrderCreated),
+			OrderUID:   order.OrderUID,
+			Type:       eventTypeOrderCreated,
+			Payload:    payload,
+			OccurredAt: time.Now(),
+			Version:    1,
+		}
+		if err := appendOrderEvent(tx, event); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("append order event: %w", err)
+		}
+		for _, projection := range projections {
+			if err := projection.Apply(ctx, tx, event, &order); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("apply projection: %w", err)
+			}
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO inbox_processed (message_id, processed_at) VALUES ($1, $2)`,
+			messageID, time.Now(),
+		); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("mark inbox processed: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// persistWithRetry retries persistOrder with exponential backoff, counting
+// every failed attempt, and gives up after maxProcessRetries.
+func persistWithRetry(ctx context.Context, order Order, messageID string) error {
+	backoff := initialBackoff
+	var lastErr error
+	for attempt := 1; attempt <= maxProcessRetries; attempt++ {
+		if err := persistOrder(ctx, db, order, messageID); err != nil {
+			lastErr = err
+			ordersFailedTotal.Inc()
+			log.Printf("Attempt %d/%d failed for order message %s: %v", attempt, maxProcessRetries, messageID, err)
+			time.Sleep(backoff)
+			backoff *= 2
+			continue
+		}
+		return nil
+	}
+	return lastErr
+}
+
+// publishToDLQTopic forwards a raw, unprocessable message to a DLQ topic so it
+// is not lost; a human or a replay tool can inspect it later.
+func publishToDLQTopic(ctx context.Context, topic string, key, value []byte) error {
+	w := &kafka.Writer{
+		Addr:  kafka.TCP(kafkaBroker),
+		Topic: topic,
+	}
+	defer w.Close()
+
+	return w.WriteMessages(ctx, kafka.Message{Key: key, Value: value})
+}
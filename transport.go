@@ -0,0 +1,25 @@
+package main
+
+import "context"
+
+// OrderSource abstracts where incoming order messages come from. kafkaSource
+// is the transport this service has always used; natsSource lets small
+// deployments run the same binary against NATS JetStream instead of standing
+// up a Kafka cluster. Selected once at startup via the TRANSPORT env var.
+type OrderSource interface {
+	// Subscribe consumes orders until ctx is canceled, durably persisting each
+	// one before calling handler so a crash mid-delivery never loses an order;
+	// handler is only invoked once the order is safely stored.
+	Subscribe(ctx context.Context, handler func(Order) error) error
+}
+
+// NewOrderSource selects an OrderSource by transport ("kafka" or "nats"),
+// defaulting to kafka so existing deployments need no configuration change.
+func NewOrderSource(transport string) OrderSource {
+	switch transport {
+	case "nats":
+		return &natsSource{}
+	default:
+		return &kafkaSource{}
+	}
+}